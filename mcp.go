@@ -3,12 +3,16 @@ package mcp
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/grafana/sobek"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/sirupsen/logrus"
@@ -16,6 +20,7 @@ import (
 	"go.k6.io/k6/js/modules"
 	k6metrics "go.k6.io/k6/metrics"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/grafana/xk6-mcp/metrics"
 )
@@ -46,10 +51,55 @@ type (
 		// SSE and Streamable HTTP
 		BaseURL string
 		Auth    AuthConfig
+
+		// Timeout bounds the initial connect, e.g. "10s". Empty keeps the
+		// previous defaults (30s for stdio/streamable HTTP, none for SSE).
+		Timeout string
+
+		// Validation controls client-side JSON Schema validation of tool
+		// arguments and structured results: "strict" fails the call, "warn"
+		// logs and proceeds, "off" (the default) skips it entirely.
+		Validation string
 	}
 
+	// AuthConfig configures how SSE and Streamable HTTP clients authenticate.
+	// BearerToken is used as a static token; the three flows below instead
+	// build an oauth2.TokenSource that refreshes itself as tokens expire.
 	AuthConfig struct {
 		BearerToken string
+
+		ClientCredentials *ClientCredentialsAuth
+		RefreshToken      *RefreshTokenAuth
+		DeviceCode        *DeviceCodeAuth
+	}
+
+	// ClientCredentialsAuth acquires tokens via the OAuth2 client
+	// credentials grant, suited to service-to-service scenarios.
+	ClientCredentialsAuth struct {
+		ClientID     string
+		ClientSecret string
+		TokenURL     string
+		Scopes       []string
+	}
+
+	// RefreshTokenAuth seeds the token source with a pre-obtained refresh
+	// token; oauth2 mints fresh access tokens from it as they expire.
+	RefreshTokenAuth struct {
+		ClientID     string
+		ClientSecret string
+		TokenURL     string
+		RefreshToken string
+		Scopes       []string
+	}
+
+	// DeviceCodeAuth drives the OAuth2 device authorization grant, for
+	// scenarios run interactively where a human can approve the device.
+	DeviceCodeAuth struct {
+		ClientID      string
+		ClientSecret  string
+		TokenURL      string
+		DeviceAuthURL string
+		Scopes        []string
 	}
 )
 
@@ -78,9 +128,32 @@ func (*RootModule) NewModuleInstance(vu modules.VU) modules.Instance {
 
 // Client wraps an MCP client session
 type Client struct {
-	ctx     context.Context
-	session *mcp.ClientSession
-	metrics *metrics.K6Metrics
+	ctx         context.Context
+	vu          modules.VU
+	session     *mcp.ClientSession
+	metrics     *metrics.K6Metrics
+	tokenSource oauth2.TokenSource
+	serverName  string
+	logger      logrus.FieldLogger
+
+	validation string
+	schemaMu   sync.RWMutex
+	schemas    map[string]*toolSchema
+
+	notifyCh        chan notificationEvent
+	notifyMu        sync.Mutex
+	notifyFns       map[string][]sobek.Callable
+	notifyMetricsMu sync.Mutex
+	lastNotifyAt    time.Time
+	done            chan struct{}
+	closeOnce       sync.Once
+}
+
+// notificationEvent carries a single server-initiated notification from the
+// go-sdk session to the client's dispatch loop.
+type notificationEvent struct {
+	method string
+	params interface{}
 }
 
 // Exports defines the JavaScript-accessible functions
@@ -113,23 +186,16 @@ func (m *MCPInstance) newStdioClient(c sobek.ConstructorCall, rt *sobek.Runtime)
 		Command: cmd,
 	}
 
-	clientObj := m.connect(rt, transport, false)
-	var client *Client
-	if err := rt.ExportTo(clientObj, &client); err != nil {
-		common.Throw(rt, fmt.Errorf("failed to extract Client: %w", err))
-	}
+	client := m.connect(rt, transport, false, cfg.Timeout)
+	client.validation = cfg.Validation
 
-	mcpMetrics := metrics.NewK6Metrics(
-		m.registry,
-		m.vu.State().Samples,
-		m.vu.State().Tags.GetCurrentValues(),
-	)
+	if client.validation != "" && client.validation != "off" {
+		if err := client.cacheToolSchemas(); err != nil {
+			common.Throw(rt, fmt.Errorf("failed to cache tool schemas: %w", err))
+		}
+	}
 
-	return rt.ToValue(&Client{
-		ctx:     m.vu.Context(),
-		session: client.session,
-		metrics: mcpMetrics,
-	}).ToObject(rt)
+	return rt.ToValue(client).ToObject(rt)
 }
 
 func (m *MCPInstance) newSSEClient(c sobek.ConstructorCall, rt *sobek.Runtime) *sobek.Object {
@@ -138,28 +204,27 @@ func (m *MCPInstance) newSSEClient(c sobek.ConstructorCall, rt *sobek.Runtime) *
 		common.Throw(rt, fmt.Errorf("invalid config: %w", err))
 	}
 
+	httpClient, tokenSource, err := m.newk6HTTPClient(cfg)
+	if err != nil {
+		common.Throw(rt, fmt.Errorf("auth setup failed: %w", err))
+	}
+
 	transport := &mcp.SSEClientTransport{
 		Endpoint:   cfg.BaseURL,
-		HTTPClient: m.newk6HTTPClient(cfg),
+		HTTPClient: httpClient,
 	}
 
-	clientObj := m.connect(rt, transport, true)
-	var client *Client
-	if err := rt.ExportTo(clientObj, &client); err != nil {
-		common.Throw(rt, fmt.Errorf("failed to extract Client: %w", err))
-	}
+	client := m.connect(rt, transport, true, cfg.Timeout)
+	client.tokenSource = tokenSource
+	client.validation = cfg.Validation
 
-	mcpMetrics := metrics.NewK6Metrics(
-		m.registry,
-		m.vu.State().Samples,
-		m.vu.State().Tags.GetCurrentValues(),
-	)
+	if client.validation != "" && client.validation != "off" {
+		if err := client.cacheToolSchemas(); err != nil {
+			common.Throw(rt, fmt.Errorf("failed to cache tool schemas: %w", err))
+		}
+	}
 
-	return rt.ToValue(&Client{
-		ctx:     m.vu.Context(),
-		session: client.session,
-		metrics: mcpMetrics,
-	}).ToObject(rt)
+	return rt.ToValue(client).ToObject(rt)
 }
 
 func (m *MCPInstance) newStreamableHTTPClient(c sobek.ConstructorCall, rt *sobek.Runtime) *sobek.Object {
@@ -168,31 +233,42 @@ func (m *MCPInstance) newStreamableHTTPClient(c sobek.ConstructorCall, rt *sobek
 		common.Throw(rt, fmt.Errorf("invalid config: %w", err))
 	}
 
+	httpClient, tokenSource, err := m.newk6HTTPClient(cfg)
+	if err != nil {
+		common.Throw(rt, fmt.Errorf("auth setup failed: %w", err))
+	}
+
 	transport := &mcp.StreamableClientTransport{
 		Endpoint:   cfg.BaseURL,
-		HTTPClient: m.newk6HTTPClient(cfg),
+		HTTPClient: httpClient,
 	}
 
-	clientObj := m.connect(rt, transport, false)
-	var client *Client
-	if err := rt.ExportTo(clientObj, &client); err != nil {
-		common.Throw(rt, fmt.Errorf("failed to extract Client: %w", err))
+	client := m.connect(rt, transport, false, cfg.Timeout)
+	client.tokenSource = tokenSource
+	client.validation = cfg.Validation
+
+	if client.validation != "" && client.validation != "off" {
+		if err := client.cacheToolSchemas(); err != nil {
+			common.Throw(rt, fmt.Errorf("failed to cache tool schemas: %w", err))
+		}
 	}
 
-	mcpMetrics := metrics.NewK6Metrics(
-		m.registry,
-		m.vu.State().Samples,
-		m.vu.State().Tags.GetCurrentValues(),
-	)
+	return rt.ToValue(client).ToObject(rt)
+}
+
+// newMetrics builds the K6Metrics for a freshly connected client, seeding
+// its static tags with the VU's current tag set plus the server tag
+// derived from the Implementation the server returned at connect time.
+func (m *MCPInstance) newMetrics(serverName string) *metrics.K6Metrics {
+	tags := m.vu.State().Tags.GetCurrentValues().Tags
+	if serverName != "" {
+		tags = tags.With("server", serverName)
+	}
 
-	return rt.ToValue(&Client{
-		ctx:     m.vu.Context(),
-		session: client.session,
-		metrics: mcpMetrics,
-	}).ToObject(rt)
+	return metrics.NewK6Metrics(m.registry, m.vu.State().Samples, tags)
 }
 
-func (m *MCPInstance) newk6HTTPClient(cfg ClientConfig) *http.Client {
+func (m *MCPInstance) newk6HTTPClient(cfg ClientConfig) (*http.Client, oauth2.TokenSource, error) {
 	var tlsConfig *tls.Config
 	if m.vu.State() != nil && m.vu.State().TLSConfig != nil {
 		tlsConfig = m.vu.State().TLSConfig.Clone()
@@ -214,53 +290,319 @@ func (m *MCPInstance) newk6HTTPClient(cfg ClientConfig) *http.Client {
 		Transport: &transport,
 	}
 
-	if cfg.Auth.BearerToken != "" {
-		ctx := context.Background()
+	tokenSource, err := m.newOAuthTokenSource(cfg.Auth, httpClient)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	if tokenSource != nil {
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+		httpClient = oauth2.NewClient(ctx, tokenSource)
+	}
 
-		token := oauth2.Token{
-			AccessToken: cfg.Auth.BearerToken,
+	return httpClient, tokenSource, nil
+}
+
+// newOAuthTokenSource builds the oauth2.TokenSource matching whichever auth
+// flow was configured, wrapping it in ReuseTokenSource so a refresh only
+// happens once the cached token's Valid() check fails; a BearerToken is
+// returned as a plain StaticTokenSource and never refreshed. httpClient is used
+// for the token endpoint calls themselves, so it picks up the same TLS and
+// dialer settings as the MCP requests it authenticates.
+func (m *MCPInstance) newOAuthTokenSource(auth AuthConfig, httpClient *http.Client) (oauth2.TokenSource, error) {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	switch {
+	case auth.ClientCredentials != nil:
+		cc := auth.ClientCredentials
+		cfg := &clientcredentials.Config{
+			ClientID:     cc.ClientID,
+			ClientSecret: cc.ClientSecret,
+			TokenURL:     cc.TokenURL,
+			Scopes:       cc.Scopes,
+		}
+		return oauth2.ReuseTokenSource(nil, cfg.TokenSource(ctx)), nil
+
+	case auth.RefreshToken != nil:
+		rt := auth.RefreshToken
+		cfg := &oauth2.Config{
+			ClientID:     rt.ClientID,
+			ClientSecret: rt.ClientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: rt.TokenURL},
+			Scopes:       rt.Scopes,
+		}
+		seed := &oauth2.Token{RefreshToken: rt.RefreshToken}
+		return oauth2.ReuseTokenSource(nil, cfg.TokenSource(ctx, seed)), nil
+
+	case auth.DeviceCode != nil:
+		dc := auth.DeviceCode
+		cfg := &oauth2.Config{
+			ClientID:     dc.ClientID,
+			ClientSecret: dc.ClientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: dc.TokenURL, DeviceAuthURL: dc.DeviceAuthURL},
+			Scopes:       dc.Scopes,
+		}
+		deviceAuth, err := cfg.DeviceAuth(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("device authorization failed: %w", err)
 		}
-		tokenSource := oauth2.StaticTokenSource(&token)
 
-		httpClient = oauth2.NewClient(ctx, tokenSource)
-	}
+		fmt.Fprintf(os.Stderr, "mcp: visit %s and enter code %s to authorize this scenario\n", deviceAuth.VerificationURI, deviceAuth.UserCode)
 
-	return httpClient
+		token, err := cfg.DeviceAccessToken(ctx, deviceAuth)
+		if err != nil {
+			return nil, fmt.Errorf("device token exchange failed: %w", err)
+		}
+		return oauth2.ReuseTokenSource(token, cfg.TokenSource(ctx, token)), nil
+
+	case auth.BearerToken != "":
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: auth.BearerToken}), nil
+
+	default:
+		return nil, nil
+	}
 }
 
-func (m *MCPInstance) connect(rt *sobek.Runtime, transport mcp.Transport, isSSE bool) *sobek.Object {
+// connect drives the MCP handshake over transport and returns the single
+// *Client instance that backs both the dispatch loop started here and the
+// sobek object eventually handed to JS — callers fill in the fields that
+// depend on their specific transport (tokenSource, validation) directly on
+// the returned pointer rather than copying its state into a second Client.
+func (m *MCPInstance) connect(rt *sobek.Runtime, transport mcp.Transport, isSSE bool, timeout string) *Client {
+	connectTimeout := 30 * time.Second
+	if isSSE {
+		connectTimeout = 0
+	}
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			common.Throw(rt, fmt.Errorf("invalid timeout %q: %w", timeout, err))
+		}
+		connectTimeout = d
+	}
+
 	var ctx context.Context
 	var cancel context.CancelFunc
-	if isSSE {
-		ctx = context.Background()
-		cancel = func() {}
+	if connectTimeout <= 0 {
+		ctx, cancel = context.Background(), func() {}
 	} else {
-		ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel = context.WithTimeout(context.Background(), connectTimeout)
 	}
 	defer cancel()
 
-	client := mcp.NewClient(&mcp.Implementation{Name: "k6", Version: "1.0.0"}, nil)
+	c := &Client{
+		ctx:       m.vu.Context(),
+		vu:        m.vu,
+		logger:    m.logger,
+		notifyCh:  make(chan notificationEvent, 64),
+		notifyFns: make(map[string][]sobek.Callable),
+		done:      make(chan struct{}),
+	}
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "k6", Version: "1.0.0"}, &mcp.ClientOptions{
+		ToolListChangedHandler: func(_ context.Context, _ *mcp.ToolListChangedRequest) {
+			c.dispatch("tools/list_changed", nil)
+		},
+		ResourceListChangedHandler: func(_ context.Context, _ *mcp.ResourceListChangedRequest) {
+			c.dispatch("resources/list_changed", nil)
+		},
+		ResourceUpdatedHandler: func(_ context.Context, r *mcp.ResourceUpdatedNotificationRequest) {
+			c.dispatch("resources/updated", r.Params)
+		},
+		PromptListChangedHandler: func(_ context.Context, _ *mcp.PromptListChangedRequest) {
+			c.dispatch("prompts/list_changed", nil)
+		},
+		ProgressNotificationHandler: func(_ context.Context, p *mcp.ProgressNotificationClientRequest) {
+			c.dispatch("notifications/progress", p.Params)
+		},
+		LoggingMessageHandler: func(_ context.Context, l *mcp.LoggingMessageRequest) {
+			c.dispatch("notifications/message", l.Params)
+		},
+	})
 	session, err := client.Connect(ctx, transport, nil)
 	if err != nil {
 		common.Throw(rt, fmt.Errorf("connection error: %w", err))
 	}
+	c.session = session
+	if res := session.InitializeResult(); res != nil && res.ServerInfo != nil {
+		c.serverName = res.ServerInfo.Name
+	}
+	c.metrics = m.newMetrics(c.serverName)
+
+	go c.dispatchLoop()
+
+	return c
+}
+
+// dispatch queues a server-initiated notification for delivery to any JS
+// callbacks registered for method. It never blocks the go-sdk read loop:
+// a full queue drops the oldest pending notification. It also pushes a
+// notification counter and, once a previous notification has been seen,
+// the inter-arrival latency since it.
+func (c *Client) dispatch(method string, params interface{}) {
+	c.notifyMetricsMu.Lock()
+	now := time.Now()
+	hasPrior := !c.lastNotifyAt.IsZero()
+	interArrival := now.Sub(c.lastNotifyAt)
+	c.lastNotifyAt = now
+	c.notifyMetricsMu.Unlock()
+
+	c.metrics.PushNotification(c.ctx, method, interArrival, hasPrior)
+
+	ev := notificationEvent{method: method, params: params}
+	select {
+	case c.notifyCh <- ev:
+	case <-c.done:
+	default:
+		select {
+		case <-c.notifyCh:
+		default:
+		}
+		select {
+		case c.notifyCh <- ev:
+		case <-c.done:
+		}
+	}
+}
+
+// dispatchLoop drains notifyCh and hands each event to the JS callbacks
+// registered via OnNotification, running them on the VU's event loop via
+// vu.RegisterCallback so sobek state is only ever touched from one
+// goroutine at a time.
+func (c *Client) dispatchLoop() {
+	for {
+		select {
+		case ev := <-c.notifyCh:
+			c.notifyMu.Lock()
+			fns := append([]sobek.Callable(nil), c.notifyFns[ev.method]...)
+			c.notifyMu.Unlock()
+
+			for _, fn := range fns {
+				fn := fn
+				ev := ev
+				callback := c.vu.RegisterCallback()
+				callback(func() error {
+					rt := c.vu.Runtime()
+					_, err := fn(sobek.Undefined(), rt.ToValue(ev.params))
+					return err
+				})
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// OnNotification registers cb to be invoked whenever the server sends a
+// notification of the given method, e.g. "resources/updated" or
+// "notifications/progress".
+func (c *Client) OnNotification(method string, cb sobek.Callable) {
+	c.notifyMu.Lock()
+	defer c.notifyMu.Unlock()
+	c.notifyFns[method] = append(c.notifyFns[method], cb)
+}
+
+// Subscribe asks the server to start sending resources/updated
+// notifications for the given URI.
+func (c *Client) Subscribe(r mcp.SubscribeParams) error {
+	return c.session.Subscribe(context.Background(), &r)
+}
+
+// Unsubscribe stops a previously started Subscribe watch.
+func (c *Client) Unsubscribe(r mcp.UnsubscribeParams) error {
+	return c.session.Unsubscribe(context.Background(), &r)
+}
+
+// Close tears down the notification dispatch loop and the underlying
+// session, so a scenario can cleanly stop watching for updates. It is safe
+// to call more than once; only the first call closes anything.
+func (c *Client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		err = c.session.Close()
+	})
+	return err
+}
+
+// withDeadline builds the context a single RPC should run under: c.ctx,
+// optionally bounded by timeout. Each call gets its own timer, so two
+// in-flight calls on the same Client never interfere with each other's
+// deadline. An empty timeout leaves c.ctx untouched so the call is still
+// bound by the VU's own lifetime.
+func (c *Client) withDeadline(timeout string) (context.Context, context.CancelFunc, error) {
+	if timeout == "" {
+		return context.WithCancel(c.ctx)
+	}
+
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid timeout %q: %w", timeout, err)
+	}
 
-	return rt.ToValue(&Client{session: session}).ToObject(rt)
+	ctx, cancel := context.WithTimeout(c.ctx, d)
+	return ctx, cancel, nil
 }
 
-func (c *Client) Ping() bool {
-	err := c.session.Ping(context.Background(), &mcp.PingParams{})
+func (c *Client) Ping(r PingParams) bool {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return false
+	}
+	defer cancel()
+
+	err = c.session.Ping(ctx, &mcp.PingParams{})
 	return err == nil
 }
 
-func (c *Client) ListTools(r mcp.ListToolsParams) (*mcp.ListToolsResult, error) {
-	return c.session.ListTools(context.Background(), &r)
+// PingParams optionally bounds how long Ping waits for a response.
+type PingParams struct {
+	Timeout string
+}
+
+// AccessToken returns the access token currently held by the client's
+// oauth2.TokenSource, refreshing it first if it has expired. It returns an
+// error if the client wasn't configured with an OAuth2 or bearer auth flow.
+func (c *Client) AccessToken() (string, error) {
+	if c.tokenSource == nil {
+		return "", fmt.Errorf("client was not configured with an auth flow")
+	}
+
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain access token: %w", err)
+	}
+
+	return token.AccessToken, nil
+}
+
+// TagMetrics layers scenario-level tags (e.g. {server: "foo"}) on top of
+// every metric this client pushes from now on, matching the ergonomics of
+// k6's built-in http module.
+func (c *Client) TagMetrics(tags map[string]string) {
+	c.metrics.WithTags(tags)
+}
+
+// ListToolsParams wraps mcp.ListToolsParams with an optional per-call
+// Timeout, e.g. "5s".
+type ListToolsParams struct {
+	mcp.ListToolsParams
+	Timeout string
+}
+
+func (c *Client) ListTools(r ListToolsParams) (*mcp.ListToolsResult, error) {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return c.session.ListTools(ctx, &r.ListToolsParams)
 }
 
 type ListAllToolsParams struct {
-	Meta mcp.Meta
+	Meta    mcp.Meta
+	Timeout string
 }
 
 type ListAllToolsResult struct {
@@ -272,17 +614,24 @@ func (c *Client) ListAllTools(r ListAllToolsParams) (*ListAllToolsResult, error)
 		r.Meta = mcp.Meta{}
 	}
 
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	var allTools []mcp.Tool
 	cursor := ""
+	pages := 0
 	start := time.Now()
-	var err error
 	for {
 		params := &mcp.ListToolsParams{Meta: r.Meta}
 		if cursor != "" {
 			params.Cursor = cursor
 		}
 		var result *mcp.ListToolsResult
-		result, err = c.session.ListTools(context.Background(), params)
+		result, err = c.session.ListTools(ctx, params)
+		pages++
 		if err != nil {
 			break
 		}
@@ -299,7 +648,9 @@ func (c *Client) ListAllTools(r ListAllToolsParams) (*ListAllToolsResult, error)
 		cursor = result.NextCursor
 	}
 
-	c.metrics.Push(c.ctx, "ListAllTools", time.Since(start), err)
+	c.metrics.Push(c.ctx, "ListAllTools", time.Since(start), err, map[string]string{
+		"pagination_pages": strconv.Itoa(pages),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tools: %w", err)
 	}
@@ -309,43 +660,272 @@ func (c *Client) ListAllTools(r ListAllToolsParams) (*ListAllToolsResult, error)
 	}, nil
 }
 
-func (c *Client) CallTool(r mcp.CallToolParams) (*mcp.CallToolResult, error) {
+// toolSchema caches a tool's resolved input/output schemas so CallTool can
+// validate against them without a round trip to the server.
+type toolSchema struct {
+	input  *jsonschema.Resolved
+	output *jsonschema.Resolved
+}
+
+// cacheToolSchemas lists every tool the server exposes and resolves its
+// InputSchema and OutputSchema, ready for CallTool to validate against.
+// Called once at connect time when ClientConfig.Validation is enabled.
+func (c *Client) cacheToolSchemas() error {
+	schemas := make(map[string]*toolSchema)
+	cursor := ""
+	for {
+		params := &mcp.ListToolsParams{}
+		if cursor != "" {
+			params.Cursor = cursor
+		}
+
+		result, err := c.session.ListTools(context.Background(), params)
+		if err != nil {
+			return fmt.Errorf("failed to list tools for schema caching: %w", err)
+		}
+
+		for _, t := range result.Tools {
+			if t == nil {
+				continue
+			}
+
+			ts := &toolSchema{}
+			if t.InputSchema != nil {
+				schema, ok := t.InputSchema.(*jsonschema.Schema)
+				if !ok {
+					return fmt.Errorf("tool %q input schema is %T, not *jsonschema.Schema", t.Name, t.InputSchema)
+				}
+				if ts.input, err = schema.Resolve(nil); err != nil {
+					return fmt.Errorf("failed to resolve input schema for tool %q: %w", t.Name, err)
+				}
+			}
+			if t.OutputSchema != nil {
+				schema, ok := t.OutputSchema.(*jsonschema.Schema)
+				if !ok {
+					return fmt.Errorf("tool %q output schema is %T, not *jsonschema.Schema", t.Name, t.OutputSchema)
+				}
+				if ts.output, err = schema.Resolve(nil); err != nil {
+					return fmt.Errorf("failed to resolve output schema for tool %q: %w", t.Name, err)
+				}
+			}
+			schemas[t.Name] = ts
+		}
+
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	c.schemaMu.Lock()
+	c.schemas = schemas
+	c.schemaMu.Unlock()
+	return nil
+}
+
+// GetSchema returns the cached, unresolved input schema for tool, or nil if
+// the client wasn't configured with validation or the tool is unknown.
+func (c *Client) GetSchema(tool string) *jsonschema.Schema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
+
+	ts, ok := c.schemas[tool]
+	if !ok || ts.input == nil {
+		return nil
+	}
+	return ts.input.Schema()
+}
+
+// validateArguments checks args against tool's cached input schema, if any.
+func (c *Client) validateArguments(tool string, args map[string]any) error {
+	c.schemaMu.RLock()
+	ts, ok := c.schemas[tool]
+	c.schemaMu.RUnlock()
+
+	if !ok || ts.input == nil {
+		return nil
+	}
+	if err := ts.input.Validate(args); err != nil {
+		return fmt.Errorf("tool %q arguments failed schema validation: %w", tool, err)
+	}
+	return nil
+}
+
+// validateOutput checks a tool's structured content against its cached
+// output schema, if any.
+func (c *Client) validateOutput(tool string, result *mcp.CallToolResult) error {
+	c.schemaMu.RLock()
+	ts, ok := c.schemas[tool]
+	c.schemaMu.RUnlock()
+
+	if !ok || ts.output == nil || result == nil || result.StructuredContent == nil {
+		return nil
+	}
+	if err := ts.output.Validate(result.StructuredContent); err != nil {
+		return fmt.Errorf("tool %q output failed schema validation: %w", tool, err)
+	}
+	return nil
+}
+
+// reportValidationError pushes a validation-failure metric sample and,
+// under "strict", returns err to fail the call; under "warn", it logs and
+// lets the call proceed.
+func (c *Client) reportValidationError(err error, tags map[string]string) error {
+	failedTags := make(map[string]string, len(tags)+1)
+	for k, v := range tags {
+		failedTags[k] = v
+	}
+	failedTags["mcp_validation_failed"] = "true"
+	c.metrics.Push(c.ctx, "CallTool", 0, err, failedTags)
+
+	if c.validation == "strict" {
+		return err
+	}
+
+	c.logger.WithError(err).Warn("mcp: schema validation failed")
+	return nil
+}
+
+// CallToolParams wraps mcp.CallToolParams with an optional per-call
+// Timeout, e.g. "5s".
+type CallToolParams struct {
+	mcp.CallToolParams
+	Timeout string
+}
+
+func (c *Client) CallTool(r CallToolParams) (*mcp.CallToolResult, error) {
+	tags := map[string]string{"tool": r.Name}
+
+	if c.validation != "" && c.validation != "off" {
+		if err := c.validateArguments(r.Name, r.Arguments); err != nil {
+			if err := c.reportValidationError(err, tags); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	start := time.Now()
-	result, err := c.session.CallTool(c.ctx, &r)
-	c.metrics.Push(c.ctx, "CallTool", time.Since(start), err)
+	result, err := c.session.CallTool(ctx, &r.CallToolParams)
+
+	if err == nil && c.validation != "" && c.validation != "off" {
+		if verr := c.validateOutput(r.Name, result); verr != nil {
+			if verr := c.reportValidationError(verr, tags); verr != nil {
+				return nil, verr
+			}
+		}
+	}
+
+	isError := err == nil && result != nil && result.IsError
+	tags["is_error"] = strconv.FormatBool(isError)
+	c.metrics.Push(c.ctx, "CallTool", time.Since(start), err, tags)
+
+	if err != nil {
+		c.metrics.PushToolError(c.ctx, true, tags)
+	} else if result != nil {
+		if result.IsError {
+			c.metrics.PushToolError(c.ctx, false, tags)
+		}
+		if payload, mErr := json.Marshal(result.Content); mErr == nil {
+			c.metrics.PushPayloadSize(c.ctx, "CallTool", len(payload), tags)
+		}
+	}
+
 	return result, err
 }
 
-func (c *Client) ListResources(r mcp.ListResourcesParams) (*mcp.ListResourcesResult, error) {
+// ListResourcesParams wraps mcp.ListResourcesParams with an optional
+// per-call Timeout, e.g. "5s".
+type ListResourcesParams struct {
+	mcp.ListResourcesParams
+	Timeout string
+}
+
+func (c *Client) ListResources(r ListResourcesParams) (*mcp.ListResourcesResult, error) {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	start := time.Now()
-	res, err := c.session.ListResources(context.Background(), &r)
-	c.metrics.Push(c.ctx, "ListResources", time.Since(start), err)
+	res, err := c.session.ListResources(ctx, &r.ListResourcesParams)
+	c.metrics.Push(c.ctx, "ListResources", time.Since(start), err, nil)
 	return res, err
 }
 
-func (c *Client) ReadResource(r mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+// ReadResourceParams wraps mcp.ReadResourceParams with an optional
+// per-call Timeout, e.g. "5s".
+type ReadResourceParams struct {
+	mcp.ReadResourceParams
+	Timeout string
+}
+
+func (c *Client) ReadResource(r ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	start := time.Now()
-	res, err := c.session.ReadResource(context.Background(), &r)
-	c.metrics.Push(c.ctx, "ReadResource", time.Since(start), err)
+	res, err := c.session.ReadResource(ctx, &r.ReadResourceParams)
+	c.metrics.Push(c.ctx, "ReadResource", time.Since(start), err, map[string]string{
+		"resource_uri": r.URI,
+	})
 	return res, err
 }
 
-func (c *Client) ListPrompts(r mcp.ListPromptsParams) (*mcp.ListPromptsResult, error) {
+// ListPromptsParams wraps mcp.ListPromptsParams with an optional per-call
+// Timeout, e.g. "5s".
+type ListPromptsParams struct {
+	mcp.ListPromptsParams
+	Timeout string
+}
+
+func (c *Client) ListPrompts(r ListPromptsParams) (*mcp.ListPromptsResult, error) {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	start := time.Now()
-	res, err := c.session.ListPrompts(context.Background(), &r)
-	c.metrics.Push(c.ctx, "ListPrompts", time.Since(start), err)
+	res, err := c.session.ListPrompts(ctx, &r.ListPromptsParams)
+	c.metrics.Push(c.ctx, "ListPrompts", time.Since(start), err, nil)
 	return res, err
 }
 
-func (c *Client) GetPrompt(r mcp.GetPromptParams) (*mcp.GetPromptResult, error) {
+// GetPromptParams wraps mcp.GetPromptParams with an optional per-call
+// Timeout, e.g. "5s".
+type GetPromptParams struct {
+	mcp.GetPromptParams
+	Timeout string
+}
+
+func (c *Client) GetPrompt(r GetPromptParams) (*mcp.GetPromptResult, error) {
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	start := time.Now()
-	res, err := c.session.GetPrompt(context.Background(), &r)
-	c.metrics.Push(c.ctx, "GetPrompt", time.Since(start), err)
+	res, err := c.session.GetPrompt(ctx, &r.GetPromptParams)
+	c.metrics.Push(c.ctx, "GetPrompt", time.Since(start), err, map[string]string{
+		"prompt": r.Name,
+	})
 	return res, err
 }
 
 type ListAllResourcesParams struct {
-	Meta mcp.Meta
+	Meta    mcp.Meta
+	Timeout string
 }
 
 type ListAllResourcesResult struct {
@@ -357,17 +937,24 @@ func (c *Client) ListAllResources(r ListAllResourcesParams) (*ListAllResourcesRe
 		r.Meta = mcp.Meta{}
 	}
 
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	var allResources []mcp.Resource
 	cursor := ""
+	pages := 0
 	start := time.Now()
-	var err error
 	for {
 		params := &mcp.ListResourcesParams{Meta: r.Meta}
 		if cursor != "" {
 			params.Cursor = cursor
 		}
 		var result *mcp.ListResourcesResult
-		result, err = c.session.ListResources(context.Background(), params)
+		result, err = c.session.ListResources(ctx, params)
+		pages++
 		if err != nil {
 			break
 		}
@@ -384,7 +971,9 @@ func (c *Client) ListAllResources(r ListAllResourcesParams) (*ListAllResourcesRe
 		cursor = result.NextCursor
 	}
 
-	c.metrics.Push(c.ctx, "ListAllResources", time.Since(start), err)
+	c.metrics.Push(c.ctx, "ListAllResources", time.Since(start), err, map[string]string{
+		"pagination_pages": strconv.Itoa(pages),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list resources: %w", err)
 	}
@@ -395,7 +984,8 @@ func (c *Client) ListAllResources(r ListAllResourcesParams) (*ListAllResourcesRe
 }
 
 type ListAllPromptsParams struct {
-	Meta mcp.Meta
+	Meta    mcp.Meta
+	Timeout string
 }
 
 type ListAllPromptsResult struct {
@@ -407,17 +997,24 @@ func (c *Client) ListAllPrompts(r ListAllPromptsParams) (*ListAllPromptsResult,
 		r.Meta = mcp.Meta{}
 	}
 
+	ctx, cancel, err := c.withDeadline(r.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	var allPrompts []mcp.Prompt
 	cursor := ""
+	pages := 0
 	start := time.Now()
-	var err error
 	for {
 		params := &mcp.ListPromptsParams{Meta: r.Meta}
 		if cursor != "" {
 			params.Cursor = cursor
 		}
 		var result *mcp.ListPromptsResult
-		result, err = c.session.ListPrompts(context.Background(), params)
+		result, err = c.session.ListPrompts(ctx, params)
+		pages++
 		if err != nil {
 			break
 		}
@@ -434,7 +1031,9 @@ func (c *Client) ListAllPrompts(r ListAllPromptsParams) (*ListAllPromptsResult,
 		cursor = result.NextCursor
 	}
 
-	c.metrics.Push(c.ctx, "ListAllPrompts", time.Since(start), err)
+	c.metrics.Push(c.ctx, "ListAllPrompts", time.Since(start), err, map[string]string{
+		"pagination_pages": strconv.Itoa(pages),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list prompts: %w", err)
 	}
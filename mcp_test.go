@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	mcp "github.com/grafana/xk6-mcp"
@@ -108,3 +109,200 @@ func TestStreamableBearerAuth(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, jwtToken, observedToken)
 }
+
+func TestStreamableClientCredentialsAuth(t *testing.T) {
+	handler, err := streamableHandler(t)
+	require.NoError(t, err)
+
+	var observedToken string
+	handlerFunc := func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header["Authorization"]
+		if len(authHeader) > 0 {
+			token, found := strings.CutPrefix(authHeader[0], "Bearer ")
+			if found {
+				observedToken = token
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(handlerFunc))
+	defer ts.Close()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"cc-token","token_type":"Bearer","expires_in":3600}`)
+	}))
+	defer tokenServer.Close()
+
+	vu := setupRuntime(t)
+
+	_, err = vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({
+      base_url: "%s",
+      auth: {
+        client_credentials: {
+          client_id: "id",
+          client_secret: "secret",
+          token_url: "%s"
+        }
+      }
+    });`, ts.URL, tokenServer.URL),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "cc-token", observedToken)
+}
+
+func TestCallToolStrictValidationRejectsBadArguments(t *testing.T) {
+	handler, err := streamableHandler(t)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	_, err = vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({
+      base_url: %q,
+      validation: "strict"
+    });
+    var threw = false;
+    try {
+      client.callTool({ name: %q, arguments: { id: "not-a-number" } });
+    } catch (e) {
+      threw = true;
+    }`, ts.URL, toolName),
+	)
+	require.NoError(t, err)
+
+	threw, err := vu.RuntimeField.RunString(`threw`)
+	require.NoError(t, err)
+	assert.True(t, threw.ToBoolean())
+}
+
+func TestGetSchemaReturnsCachedInputSchema(t *testing.T) {
+	handler, err := streamableHandler(t)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	v, err := vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({
+      base_url: %q,
+      validation: "warn"
+    });
+    client.getSchema(%q) !== null;`, ts.URL, toolName),
+	)
+	require.NoError(t, err)
+	assert.True(t, v.ToBoolean())
+}
+
+func TestCallToolIsErrorResult(t *testing.T) {
+	inputSchema, err := jsonschema.For[MyToolInput](nil)
+	require.NoError(t, err)
+
+	erroringHandler := func(context.Context, *mcpsdk.CallToolRequest, MyToolInput) (*mcpsdk.CallToolResult, any, error) {
+		return &mcpsdk.CallToolResult{IsError: true}, nil, nil
+	}
+
+	server := mcpsdk.NewServer(&mcpsdk.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	mcpsdk.AddTool(server, &mcpsdk.Tool{Name: toolName, InputSchema: inputSchema}, erroringHandler)
+	handler := mcpsdk.NewStreamableHTTPHandler(func(*http.Request) *mcpsdk.Server {
+		return server
+	}, &mcpsdk.StreamableHTTPOptions{Stateless: true})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	v, err := vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({ base_url: %q });
+    client.tagMetrics({ server: "test" });
+    const result = client.callTool({ name: %q, arguments: { id: 1 } });
+    result.is_error;`, ts.URL, toolName),
+	)
+	require.NoError(t, err)
+	assert.True(t, v.ToBoolean())
+}
+
+func TestCallToolTimeout(t *testing.T) {
+	inputSchema, err := jsonschema.For[MyToolInput](nil)
+	require.NoError(t, err)
+
+	slowHandler := func(ctx context.Context, _ *mcpsdk.CallToolRequest, _ MyToolInput) (*mcpsdk.CallToolResult, any, error) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-ctx.Done():
+		}
+		return nil, MyToolOutput{toolName}, nil
+	}
+
+	server := mcpsdk.NewServer(&mcpsdk.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	mcpsdk.AddTool(server, &mcpsdk.Tool{Name: toolName, InputSchema: inputSchema}, slowHandler)
+	handler := mcpsdk.NewStreamableHTTPHandler(func(*http.Request) *mcpsdk.Server {
+		return server
+	}, &mcpsdk.StreamableHTTPOptions{Stateless: true})
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	_, err = vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({ base_url: %q });
+    var threw = false;
+    try {
+      client.callTool({ name: %q, arguments: { id: 1 }, timeout: "20ms" });
+    } catch (e) {
+      threw = true;
+    }`, ts.URL, toolName),
+	)
+	require.NoError(t, err)
+
+	threw, err := vu.RuntimeField.RunString(`threw`)
+	require.NoError(t, err)
+	assert.True(t, threw.ToBoolean())
+}
+
+func TestCloseIsIdempotent(t *testing.T) {
+	handler, err := streamableHandler(t)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	_, err = vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({ base_url: %q });
+    client.close();
+    client.close();`, ts.URL),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestOnNotificationRegistration(t *testing.T) {
+	handler, err := streamableHandler(t)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	vu := setupRuntime(t)
+
+	_, err = vu.RuntimeField.RunString(
+		fmt.Sprintf(`const client = mcp.StreamableHTTPClient({ base_url: %q });
+    var received = null;
+    client.onNotification("resources/updated", function(p) { received = p; });
+    client.close();`, ts.URL),
+	)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,180 @@
+// Package metrics wires the MCP client's RPC outcomes into k6's metrics
+// pipeline so scenarios get per-call latency and error data for free.
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.k6.io/k6/metrics"
+)
+
+// K6Metrics holds the metrics emitted by the MCP client and the sample
+// sink/tag set they should be pushed with.
+type K6Metrics struct {
+	registry *metrics.Registry
+	samples  chan<- metrics.SampleContainer
+
+	tagsMu sync.RWMutex
+	tags   *metrics.TagSet
+
+	duration             *metrics.Metric
+	errors               *metrics.Metric
+	payloadSize          *metrics.Metric
+	toolErrorTotal       *metrics.Metric
+	notificationTotal    *metrics.Metric
+	notificationInterval *metrics.Metric
+}
+
+// NewK6Metrics registers the MCP client metrics against registry and binds
+// them to the calling VU's sample output and current tag set. tags is the
+// base tag set every pushed sample inherits from, typically the VU's
+// current tag set (scenario, group, ...); a nil tags falls back to the
+// registry's root tag set.
+func NewK6Metrics(registry *metrics.Registry, samples chan<- metrics.SampleContainer, tags *metrics.TagSet) *K6Metrics {
+	duration, err := registry.NewMetric("mcp_req_duration", metrics.Trend, metrics.Time)
+	if err != nil {
+		panic(err)
+	}
+
+	errors, err := registry.NewMetric("mcp_req_errors", metrics.Counter)
+	if err != nil {
+		panic(err)
+	}
+
+	payloadSize, err := registry.NewMetric("mcp_req_payload_size", metrics.Trend, metrics.Data)
+	if err != nil {
+		panic(err)
+	}
+
+	toolErrorTotal, err := registry.NewMetric("mcp_tool_error_total", metrics.Counter)
+	if err != nil {
+		panic(err)
+	}
+
+	notificationTotal, err := registry.NewMetric("mcp_notifications_total", metrics.Counter)
+	if err != nil {
+		panic(err)
+	}
+
+	notificationInterval, err := registry.NewMetric("mcp_notification_interarrival", metrics.Trend, metrics.Time)
+	if err != nil {
+		panic(err)
+	}
+
+	if tags == nil {
+		tags = registry.RootTagSet()
+	}
+
+	return &K6Metrics{
+		registry:             registry,
+		samples:              samples,
+		tags:                 tags,
+		duration:             duration,
+		errors:               errors,
+		payloadSize:          payloadSize,
+		toolErrorTotal:       toolErrorTotal,
+		notificationTotal:    notificationTotal,
+		notificationInterval: notificationInterval,
+	}
+}
+
+// WithTags layers extra static tags (e.g. {"server": "foo"}) on top of the
+// ones the client was constructed with, applying to every metric pushed
+// from this point on. Matches the ergonomics of k6's http module tagging.
+func (k *K6Metrics) WithTags(tags map[string]string) {
+	k.tagsMu.Lock()
+	defer k.tagsMu.Unlock()
+	for key, value := range tags {
+		k.tags = k.tags.With(key, value)
+	}
+}
+
+// Push records operation's latency and, if it failed, increments the error
+// counter. extra carries call-specific dimensional tags such as tool,
+// resource_uri, prompt, is_error, or pagination_pages, on top of the
+// operation name and the client's static tags.
+func (k *K6Metrics) Push(ctx context.Context, operation string, d time.Duration, err error, extra map[string]string) {
+	tags := k.tagSet(operation, extra)
+
+	metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+		TimeSeries: metrics.TimeSeries{Metric: k.duration, Tags: tags},
+		Time:       time.Now(),
+		Value:      metrics.D(d),
+	})
+
+	if err != nil {
+		metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+			TimeSeries: metrics.TimeSeries{Metric: k.errors, Tags: tags},
+			Time:       time.Now(),
+			Value:      1,
+		})
+	}
+}
+
+// PushPayloadSize records the marshaled size in bytes of a call's response
+// content, tagged the same way as the Push call for that operation.
+func (k *K6Metrics) PushPayloadSize(ctx context.Context, operation string, bytes int, extra map[string]string) {
+	metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+		TimeSeries: metrics.TimeSeries{Metric: k.payloadSize, Tags: k.tagSet(operation, extra)},
+		Time:       time.Now(),
+		Value:      float64(bytes),
+	})
+}
+
+// PushToolError increments mcp_tool_error_total, tagged with error_type to
+// distinguish a transport-level failure (the RPC itself errored) from a
+// business failure (CallToolResult.IsError was true).
+func (k *K6Metrics) PushToolError(ctx context.Context, transportErr bool, extra map[string]string) {
+	errorType := "business"
+	if transportErr {
+		errorType = "transport"
+	}
+
+	tagged := map[string]string{"error_type": errorType}
+	for key, value := range extra {
+		tagged[key] = value
+	}
+
+	metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+		TimeSeries: metrics.TimeSeries{Metric: k.toolErrorTotal, Tags: k.tagSet("CallTool", tagged)},
+		Time:       time.Now(),
+		Value:      1,
+	})
+}
+
+// PushNotification increments the notification counter for a server-pushed
+// event, tagged with method (e.g. "resources/updated"). hasInterArrival is
+// false for the very first notification a client observes, when there is no
+// prior arrival to measure the gap from.
+func (k *K6Metrics) PushNotification(ctx context.Context, method string, interArrival time.Duration, hasInterArrival bool) {
+	tags := k.tagSet("Notification", map[string]string{"method": method})
+
+	metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+		TimeSeries: metrics.TimeSeries{Metric: k.notificationTotal, Tags: tags},
+		Time:       time.Now(),
+		Value:      1,
+	})
+
+	if hasInterArrival {
+		metrics.PushIfNotDone(ctx, k.samples, metrics.Sample{
+			TimeSeries: metrics.TimeSeries{Metric: k.notificationInterval, Tags: tags},
+			Time:       time.Now(),
+			Value:      metrics.D(interArrival),
+		})
+	}
+}
+
+// tagSet builds the client's static tags plus the operation name and any
+// per-call tags supplied by the caller.
+func (k *K6Metrics) tagSet(operation string, extra map[string]string) *metrics.TagSet {
+	k.tagsMu.RLock()
+	set := k.tags.With("operation", operation)
+	k.tagsMu.RUnlock()
+
+	for key, value := range extra {
+		set = set.With(key, value)
+	}
+	return set
+}